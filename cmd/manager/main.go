@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command manager runs the KServe controller manager and its admission webhooks.
+package main
+
+import (
+	"os"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	srcontroller "github.com/kserve/kserve/pkg/controller/v1alpha1/servingruntime"
+	srwebhook "github.com/kserve/kserve/pkg/webhook/admission/servingruntime"
+)
+
+var setupLog = ctrl.Log.WithName("setup")
+
+func main() {
+	ctrl.SetLogger(zap.New())
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		setupLog.Error(err, "unable to add serving v1alpha1 to scheme")
+		os.Exit(1)
+	}
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		setupLog.Error(err, "unable to add apps/v1 to scheme")
+		os.Exit(1)
+	}
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{Scheme: scheme})
+	if err != nil {
+		setupLog.Error(err, "unable to start manager")
+		os.Exit(1)
+	}
+
+	mgr.GetWebhookServer().Register(
+		"/validate-serving-kserve-io-v1alpha1-servingruntime",
+		&webhook.Admission{Handler: srwebhook.NewValidator(mgr.GetClient(), scheme)},
+	)
+
+	if err := (&srcontroller.ServingRuntimeReconciler{Client: mgr.GetClient()}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ServingRuntime")
+		os.Exit(1)
+	}
+	if err := (&srcontroller.ClusterServingRuntimeReconciler{Client: mgr.GetClient()}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterServingRuntime")
+		os.Exit(1)
+	}
+
+	setupLog.Info("starting manager")
+	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		setupLog.Error(err, "problem running manager")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+// ServingRuntimeCandidate is a single namespace-scoped ServingRuntime or cluster-scoped
+// ClusterServingRuntime under consideration for automatic selection of a model format.
+// Callers build this slice from both kinds so they can be ranked together.
+type ServingRuntimeCandidate struct {
+	Name          string
+	ClusterScoped bool
+	Spec          ServingRuntimeSpec
+}
+
+// SortServingRuntimeCandidates filters candidates down to the ones eligible for automatic
+// selection of the given model format, version and protocol version, and orders the result
+// from most to least preferred. The ranking rules are, in order:
+//  1. highest Priority declared for the matching SupportedModelTypes entry
+//  2. on a priority tie, the candidate that pins the specific model version
+//  3. on a further tie, namespace-scoped ServingRuntime over ClusterServingRuntime
+//  4. on a further tie, lexicographic order of Name, for determinism
+//
+// An error is returned if two eligible candidates declare the same priority for the same
+// (modelFormat, version, protocolVersion) and either both or neither of them pin the
+// requested version, since that leaves the selection ambiguous. A version-pinned candidate
+// and a generic one at the same priority do not collide; rule 2 orders them.
+func SortServingRuntimeCandidates(candidates []ServingRuntimeCandidate, modelFormat string, modelVersion string, protocolVersion constants.InferenceServiceProtocol) ([]ServingRuntimeCandidate, error) {
+	eligible := make([]ServingRuntimeCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		if !isEligibleCandidate(candidate, modelFormat, modelVersion, protocolVersion) {
+			continue
+		}
+		eligible = append(eligible, candidate)
+	}
+
+	if err := checkPriorityCollisions(eligible, modelFormat, modelVersion, protocolVersion); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(eligible, func(i, j int) bool {
+		return candidateLess(eligible[i], eligible[j], modelFormat, modelVersion)
+	})
+	return eligible, nil
+}
+
+func isEligibleCandidate(candidate ServingRuntimeCandidate, modelFormat string, modelVersion string, protocolVersion constants.InferenceServiceProtocol) bool {
+	if candidate.Spec.IsDisabled() {
+		return false
+	}
+	if !candidate.Spec.SupportsProtocolVersion(protocolVersion) {
+		return false
+	}
+	return matchingSupportedType(candidate.Spec, modelFormat, modelVersion) != nil
+}
+
+// matchingSupportedType returns the SupportedModelTypes entry that makes this runtime
+// eligible for automatic selection of modelFormat/modelVersion, or nil if none does. When
+// more than one entry matches, the entry that pins the specific requested version is
+// preferred over a generic, version-less entry, regardless of their order in the slice.
+func matchingSupportedType(spec ServingRuntimeSpec, modelFormat string, modelVersion string) *Framework {
+	var genericMatch *Framework
+	for i := range spec.SupportedModelTypes {
+		supportedType := &spec.SupportedModelTypes[i]
+		if supportedType.AutoSelect == nil || !*supportedType.AutoSelect {
+			continue
+		}
+		if !strings.EqualFold(supportedType.Name, modelFormat) {
+			continue
+		}
+		if supportedType.Version == nil {
+			if genericMatch == nil {
+				genericMatch = supportedType
+			}
+			continue
+		}
+		if modelVersion == "" || *supportedType.Version != modelVersion {
+			// A version-pinned entry only matches a query for that exact version; an
+			// unversioned query must not pick up a version-pinned entry.
+			continue
+		}
+		// A version-pinned entry is the most specific possible match; return immediately.
+		return supportedType
+	}
+	return genericMatch
+}
+
+func pinsVersion(spec ServingRuntimeSpec, modelFormat string, modelVersion string) bool {
+	supportedType := matchingSupportedType(spec, modelFormat, modelVersion)
+	return supportedType != nil && supportedType.Version != nil && modelVersion != ""
+}
+
+func priorityValue(spec ServingRuntimeSpec, modelFormat string, modelVersion string) int32 {
+	if priority := spec.GetPriority(modelFormat, modelVersion); priority != nil {
+		return *priority
+	}
+	return 0
+}
+
+func candidateLess(a, b ServingRuntimeCandidate, modelFormat string, modelVersion string) bool {
+	aPriority, bPriority := priorityValue(a.Spec, modelFormat, modelVersion), priorityValue(b.Spec, modelFormat, modelVersion)
+	if aPriority != bPriority {
+		return aPriority > bPriority
+	}
+
+	aPins, bPins := pinsVersion(a.Spec, modelFormat, modelVersion), pinsVersion(b.Spec, modelFormat, modelVersion)
+	if aPins != bPins {
+		return aPins
+	}
+
+	if a.ClusterScoped != b.ClusterScoped {
+		return !a.ClusterScoped
+	}
+
+	return a.Name < b.Name
+}
+
+// checkPriorityCollisions rejects configurations where two enabled, eligible runtimes
+// declare the same priority for the same (modelFormat, version, protocolVersion) tuple,
+// since the tie-breaking rules cannot then pick a winner deterministically. A runtime
+// that pins the exact version always outranks one that doesn't, so only runtimes that
+// agree on whether they pin the version can collide with each other.
+func checkPriorityCollisions(candidates []ServingRuntimeCandidate, modelFormat string, modelVersion string, protocolVersion constants.InferenceServiceProtocol) error {
+	seenByBucket := map[bool]map[int32]ServingRuntimeCandidate{true: {}, false: {}}
+	for _, candidate := range candidates {
+		priority := candidate.Spec.GetPriority(modelFormat, modelVersion)
+		if priority == nil {
+			continue
+		}
+		bucket := seenByBucket[pinsVersion(candidate.Spec, modelFormat, modelVersion)]
+		if other, ok := bucket[*priority]; ok && (other.Name != candidate.Name || other.ClusterScoped != candidate.ClusterScoped) {
+			return fmt.Errorf("runtimes %q and %q have the same priority %d for model format %q protocol %q",
+				other.Name, candidate.Name, *priority, modelFormat, protocolVersion)
+		}
+		bucket[*priority] = candidate
+	}
+	return nil
+}
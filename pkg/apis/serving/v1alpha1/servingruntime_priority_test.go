@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+)
+
+func boolPtr(b bool) *bool     { return &b }
+func int32Ptr(i int32) *int32  { return &i }
+func stringPtr(s string) *string { return &s }
+
+func autoSelectFramework(name string, version *string, priority int32) Framework {
+	return Framework{Name: name, Version: version, Priority: int32Ptr(priority), AutoSelect: boolPtr(true)}
+}
+
+func TestSortServingRuntimeCandidatesOrdersByPriorityThenVersionPin(t *testing.T) {
+	candidates := []ServingRuntimeCandidate{
+		{Name: "b-low-priority", Spec: ServingRuntimeSpec{SupportedModelTypes: []Framework{autoSelectFramework("sklearn", nil, 1)}}},
+		{Name: "a-high-priority", Spec: ServingRuntimeSpec{SupportedModelTypes: []Framework{autoSelectFramework("sklearn", nil, 10)}}},
+		{Name: "c-pins-version", ClusterScoped: true, Spec: ServingRuntimeSpec{SupportedModelTypes: []Framework{autoSelectFramework("sklearn", stringPtr("1"), 10)}}},
+		{Name: "d-disabled", Spec: ServingRuntimeSpec{Disabled: boolPtr(true), SupportedModelTypes: []Framework{autoSelectFramework("sklearn", nil, 100)}}},
+	}
+
+	sorted, err := SortServingRuntimeCandidates(candidates, "sklearn", "1", "v1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantOrder := []string{"c-pins-version", "a-high-priority", "b-low-priority"}
+	if len(sorted) != len(wantOrder) {
+		t.Fatalf("got %d candidates, want %d: %+v", len(sorted), len(wantOrder), sorted)
+	}
+	for i, name := range wantOrder {
+		if sorted[i].Name != name {
+			t.Errorf("position %d: got %q, want %q", i, sorted[i].Name, name)
+		}
+	}
+}
+
+func TestSortServingRuntimeCandidatesRejectsPriorityCollision(t *testing.T) {
+	candidates := []ServingRuntimeCandidate{
+		{Name: "runtime-a", Spec: ServingRuntimeSpec{SupportedModelTypes: []Framework{autoSelectFramework("sklearn", nil, 5)}}},
+		{Name: "runtime-b", Spec: ServingRuntimeSpec{SupportedModelTypes: []Framework{autoSelectFramework("sklearn", nil, 5)}}},
+	}
+
+	if _, err := SortServingRuntimeCandidates(candidates, "sklearn", "", "v1"); err == nil {
+		t.Fatal("expected a priority collision error, got nil")
+	}
+}
+
+func TestGetPriorityPrefersVersionPinnedEntryOverGenericRegardlessOfOrder(t *testing.T) {
+	spec := ServingRuntimeSpec{
+		SupportedModelTypes: []Framework{
+			autoSelectFramework("sklearn", nil, 1),
+			autoSelectFramework("sklearn", stringPtr("1"), 10),
+		},
+	}
+
+	got := spec.GetPriority("sklearn", "1")
+	if got == nil || *got != 10 {
+		t.Fatalf("got %v, want priority 10 from the version-pinned entry", got)
+	}
+}
+
+func TestGetPriorityDoesNotMatchVersionPinnedEntryForUnversionedQuery(t *testing.T) {
+	spec := ServingRuntimeSpec{
+		SupportedModelTypes: []Framework{
+			autoSelectFramework("sklearn", stringPtr("2"), 5),
+		},
+	}
+
+	if got := spec.GetPriority("sklearn", ""); got != nil {
+		t.Fatalf("got %v, want nil: a version-pinned entry must not match an unversioned query", got)
+	}
+}
+
+func TestSortServingRuntimeCandidatesDoesNotCollideGenericWithUnrelatedPinnedVersion(t *testing.T) {
+	candidates := []ServingRuntimeCandidate{
+		{Name: "generic-runtime", Spec: ServingRuntimeSpec{SupportedModelTypes: []Framework{autoSelectFramework("sklearn", nil, 5)}}},
+		{Name: "v2-pinned-runtime", Spec: ServingRuntimeSpec{SupportedModelTypes: []Framework{autoSelectFramework("sklearn", stringPtr("2"), 5)}}},
+	}
+
+	sorted, err := SortServingRuntimeCandidates(candidates, "sklearn", "", "v1")
+	if err != nil {
+		t.Fatalf("unexpected collision error: %v", err)
+	}
+	if len(sorted) != 1 || sorted[0].Name != "generic-runtime" {
+		t.Fatalf("got %+v, want only generic-runtime to be eligible for the unversioned query", sorted)
+	}
+}
@@ -19,6 +19,8 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kserve/kserve/pkg/constants"
 )
 
 type Framework struct {
@@ -30,6 +32,15 @@ type Framework struct {
 	// Can be "major", "major.minor" or "major.minor.patch".
 	// +optional
 	Version *string `json:"version,omitempty"`
+	// Priority of this serving runtime for auto selection.
+	// This is used to select the serving runtime if more than one serving runtime supports the same model format.
+	// The runtime with the highest priority is selected. Higher number means higher priority.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+	// Set to true to allow the ServingRuntime to be used for automatic model placement if
+	// this model format is specified with no explicit runtime.
+	// +optional
+	AutoSelect *bool `json:"autoSelect,omitempty"`
 }
 
 type Container struct {
@@ -91,6 +102,12 @@ type ServingRuntimeSpec struct {
 	// +optional
 	Disabled *bool `json:"disabled,omitempty"`
 
+	// Protocol versions that are supported by this runtime. Used in runtime selection to
+	// match a predictor that requests a specific protocol version. When omitted, the
+	// runtime is assumed to support only the v1 protocol.
+	// +optional
+	ProtocolVersions []constants.InferenceServiceProtocol `json:"protocolVersions,omitempty"`
+
 	ServingRuntimePodSpec `json:",inline"`
 
 	// The following fields apply to ModelMesh deployments.
@@ -108,6 +125,12 @@ type ServingRuntimeSpec struct {
 	// HTTP endpoint for inferencing
 	// +optional
 	HTTPDataEndpoint *string `json:"httpDataEndpoint,omitempty"`
+	// Note: when a runtime advertises more than one entry in ProtocolVersions, the
+	// Deployment-generation component is expected to set both GRPC_DATA_ENDPOINT and
+	// HTTP_DATA_ENDPOINT on the adapter container from the two fields above. That
+	// component does not exist in this tree yet, so there is nothing here to wire this
+	// into; see ProtocolVersions and ValidateProtocolVersions for the rest of what's
+	// implemented so far.
 
 	// Configure the number of replicas in the Deployment generated by this ServingRuntime
 	// If specified, this overrides the podsPerRuntime configuration value
@@ -124,8 +147,58 @@ type ServingRuntimeSpec struct {
 	BuiltInAdapter *BuiltInAdapter `json:"builtInAdapter,omitempty"`
 }
 
+// ServingRuntimeConditionType is the type of a condition reported on ServingRuntimeStatus.
+type ServingRuntimeConditionType string
+
+const (
+	// ServingRuntimeReady reports whether the runtime's Deployment has its desired replicas
+	// ready. It mirrors RuntimeAvailable once the rollout has had a chance to settle.
+	ServingRuntimeReady ServingRuntimeConditionType = "Ready"
+	// RuntimeAvailable reports whether the generated Deployment exists and has at least one
+	// ready replica.
+	RuntimeAvailable ServingRuntimeConditionType = "RuntimeAvailable"
+	// Validated reports whether the ServingRuntime spec passed the validating webhook's
+	// checks, e.g. no priority collisions with sibling runtimes.
+	Validated ServingRuntimeConditionType = "Validated"
+)
+
+// ServingRuntimeModelStatus reports that this runtime is currently the highest-priority
+// eligible runtime for the given model format in its namespace/cluster.
+type ServingRuntimeModelStatus struct {
+	// Name of the model format/framework, matching a SupportedModelTypes entry.
+	Name string `json:"name"`
+	// Version of the model format/framework, if the matching SupportedModelTypes entry
+	// pins one.
+	// +optional
+	Version *string `json:"version,omitempty"`
+	// Priority this runtime was selected with for the model format.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+}
+
 // ServingRuntimeStatus defines the observed state of ServingRuntime
 type ServingRuntimeStatus struct {
+	// Conditions of the ServingRuntime. The Ready condition is used by kubectl and
+	// downstream tooling to report overall health.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Replicas is the observed number of replicas of the generated Deployment.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// ReadyReplicas is the observed number of ready replicas of the generated Deployment.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// Models lists the model formats this runtime is currently the highest-priority
+	// eligible runtime for.
+	// +optional
+	Models []ServingRuntimeModelStatus `json:"models,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the controller that
+	// produced this status.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // ServerType constant for specifying the runtime name
@@ -151,11 +224,14 @@ type BuiltInAdapter struct {
 	ModelLoadingTimeoutMillis int `json:"modelLoadingTimeoutMillis,omitempty"`
 }
 
+
 // ServingRuntime is the Schema for the servingruntimes API
 // +kubebuilder:object:root=true
 // +kubebuilder:printcolumn:name="Disabled",type="boolean",JSONPath=".spec.disabled"
 // +kubebuilder:printcolumn:name="ModelType",type="string",JSONPath=".spec.supportedModelTypes[*].name"
 // +kubebuilder:printcolumn:name="Containers",type="string",JSONPath=".spec.containers[*].name"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type ServingRuntime struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -179,6 +255,8 @@ type ServingRuntimeList struct {
 // +kubebuilder:printcolumn:name="Disabled",type="boolean",JSONPath=".spec.disabled"
 // +kubebuilder:printcolumn:name="ModelType",type="string",JSONPath=".spec.supportedModelTypes[*].name"
 // +kubebuilder:printcolumn:name="Containers",type="string",JSONPath=".spec.containers[*].name"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type ClusterServingRuntime struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -204,3 +282,37 @@ func init() {
 func (srSpec *ServingRuntimeSpec) IsDisabled() bool {
 	return srSpec.Disabled != nil && *srSpec.Disabled
 }
+
+// GetPriority returns the priority of this serving runtime for the given model name and
+// version, or nil if the runtime does not declare itself auto-selectable for that model.
+// The model name is matched case-insensitively. An entry that pins a version matches only
+// a query for exactly that version, and never an unversioned query; an entry without a
+// version matches any version. When more than one entry matches, the entry
+// that pins the specific version wins over a generic, version-less entry.
+func (srSpec *ServingRuntimeSpec) GetPriority(modelName string, version string) *int32 {
+	supportedType := matchingSupportedType(*srSpec, modelName, version)
+	if supportedType == nil {
+		return nil
+	}
+	return supportedType.Priority
+}
+
+// GetProtocolVersions returns the protocol versions this runtime advertises, defaulting to
+// v1 for backward compatibility with runtimes defined before ProtocolVersions existed.
+func (srSpec *ServingRuntimeSpec) GetProtocolVersions() []constants.InferenceServiceProtocol {
+	if len(srSpec.ProtocolVersions) == 0 {
+		return []constants.InferenceServiceProtocol{constants.DefaultProtocol}
+	}
+	return srSpec.ProtocolVersions
+}
+
+// SupportsProtocolVersion reports whether this runtime advertises support for the given
+// protocol version.
+func (srSpec *ServingRuntimeSpec) SupportsProtocolVersion(protocolVersion constants.InferenceServiceProtocol) bool {
+	for _, supported := range srSpec.GetProtocolVersions() {
+		if supported == protocolVersion {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+// ValidateProtocolVersions checks that every protocol this runtime advertises is backed by
+// the corresponding data endpoint. This only applies to ModelMesh runtimes, i.e. those that
+// set GrpcMultiModelManagementEndpoint; single-model runtimes don't use the data endpoint
+// fields at all.
+func (srSpec *ServingRuntimeSpec) ValidateProtocolVersions() error {
+	if srSpec.GrpcMultiModelManagementEndpoint == nil {
+		return nil
+	}
+
+	for _, protocolVersion := range srSpec.GetProtocolVersions() {
+		switch protocolVersion {
+		case constants.ProtocolV1, constants.ProtocolV2:
+			if srSpec.HTTPDataEndpoint == nil {
+				return fmt.Errorf("protocol version %q requires httpDataEndpoint to be set", protocolVersion)
+			}
+		case constants.ProtocolGRPCV1, constants.ProtocolGRPCV2:
+			if srSpec.GrpcDataEndpoint == nil {
+				return fmt.Errorf("protocol version %q requires grpcDataEndpoint to be set", protocolVersion)
+			}
+		default:
+			return fmt.Errorf("unsupported protocol version %q", protocolVersion)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constants
+
+// InferenceServiceProtocol is the wire protocol an InferenceService/Predictor requests and
+// a ServingRuntime advertises support for.
+type InferenceServiceProtocol string
+
+const (
+	// ProtocolV1 is the V1 HTTP inference protocol.
+	ProtocolV1 InferenceServiceProtocol = "v1"
+	// ProtocolV2 is the V2 (KServe/Open Inference Protocol) HTTP inference protocol.
+	ProtocolV2 InferenceServiceProtocol = "v2"
+	// ProtocolGRPCV1 is the V1 inference protocol served over gRPC.
+	ProtocolGRPCV1 InferenceServiceProtocol = "grpc-v1"
+	// ProtocolGRPCV2 is the V2 (KServe/Open Inference Protocol) inference protocol served over gRPC.
+	ProtocolGRPCV2 InferenceServiceProtocol = "grpc-v2"
+)
+
+// DefaultProtocol is assumed for a ServingRuntime that does not list ProtocolVersions, to
+// preserve the behavior runtimes had before protocol versions were introduced.
+const DefaultProtocol = ProtocolV1
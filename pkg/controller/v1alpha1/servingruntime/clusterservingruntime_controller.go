@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servingruntime
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+)
+
+// ClusterServingRuntimeReconciler reconciles the status of a cluster-scoped
+// ClusterServingRuntime. It has no Deployment of its own, so RuntimeAvailable/Ready always
+// report true once the spec has been validated; namespace-scoped ServingRuntimes generated
+// from it carry the actual rollout state.
+type ClusterServingRuntimeReconciler struct {
+	client.Client
+}
+
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=clusterservingruntimes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=clusterservingruntimes/status,verbs=get;update;patch
+
+func (r *ClusterServingRuntimeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	runtime := &v1alpha1.ClusterServingRuntime{}
+	if err := r.Get(ctx, req.NamespacedName, runtime); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	siblings, err := listClusterSiblingCandidates(ctx, r.Client, runtime.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	runtime.Status.Conditions = applyClusterConditions(runtime.Status.Conditions, runtime.Generation)
+	runtime.Status.Models = modelsStatus(runtime.Name, true, runtime.Spec, siblings)
+	runtime.Status.ObservedGeneration = runtime.Generation
+
+	return ctrl.Result{}, r.Status().Update(ctx, runtime)
+}
+
+// SetupWithManager wires up a watch on ClusterServingRuntime itself in addition to the
+// implicit For() watch -- a priority change on one ClusterServingRuntime can flip which of
+// its siblings wins automatic selection for a model format, so every ClusterServingRuntime
+// needs to be re-evaluated.
+func (r *ClusterServingRuntimeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ClusterServingRuntime{}).
+		Watches(&v1alpha1.ClusterServingRuntime{}, handler.EnqueueRequestsFromMapFunc(r.requestsForAllClusterServingRuntimes)).
+		Complete(r)
+}
+
+func (r *ClusterServingRuntimeReconciler) requestsForAllClusterServingRuntimes(ctx context.Context, obj client.Object) []reconcile.Request {
+	runtimeList := &v1alpha1.ClusterServingRuntimeList{}
+	if err := r.List(ctx, runtimeList); err != nil {
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(runtimeList.Items))
+	for _, runtime := range runtimeList.Items {
+		if runtime.Name == obj.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{Name: runtime.Name}})
+	}
+	return requests
+}
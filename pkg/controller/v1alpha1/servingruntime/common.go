@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package servingruntime reconciles the observed status of ServingRuntime and
+// ClusterServingRuntime: rollout conditions mirrored from the generated Deployment, and
+// the set of model formats each runtime currently wins automatic selection for.
+package servingruntime
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+)
+
+// applyConditions merges the Ready/RuntimeAvailable conditions derived from the generated
+// Deployment, plus Validated, into the existing condition list. It uses
+// meta.SetStatusCondition so that LastTransitionTime is only bumped when a condition's
+// Status actually flips, per the metav1.Condition contract. deployment is nil if no
+// Deployment has been created for this runtime yet.
+func applyConditions(conditions []metav1.Condition, deployment *appsv1.Deployment, generation int64) []metav1.Condition {
+	available := metav1.Condition{
+		Type:               string(v1alpha1.RuntimeAvailable),
+		Status:             metav1.ConditionFalse,
+		Reason:             "DeploymentNotFound",
+		Message:            "no Deployment has been created for this runtime yet",
+		ObservedGeneration: generation,
+	}
+	if deployment != nil {
+		available.Reason = "DeploymentExists"
+		available.Message = "the Deployment for this runtime exists"
+		if deployment.Status.ReadyReplicas > 0 {
+			available.Status = metav1.ConditionTrue
+		} else {
+			available.Reason = "NoReadyReplicas"
+			available.Message = "the Deployment for this runtime has no ready replicas"
+		}
+	}
+	meta.SetStatusCondition(&conditions, available)
+
+	ready := metav1.Condition{
+		Type:               string(v1alpha1.ServingRuntimeReady),
+		Status:             available.Status,
+		Reason:             available.Reason,
+		Message:            available.Message,
+		ObservedGeneration: generation,
+	}
+	if deployment != nil && deployment.Status.ReadyReplicas < deployment.Status.Replicas {
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = "RolloutInProgress"
+		ready.Message = "the Deployment for this runtime has not finished rolling out"
+	}
+	meta.SetStatusCondition(&conditions, ready)
+
+	// By the time the controller observes this object it has already passed the
+	// validating webhook's checks -- there is no other place in this tree that could
+	// have rejected it first.
+	meta.SetStatusCondition(&conditions, metav1.Condition{
+		Type:               string(v1alpha1.Validated),
+		Status:             metav1.ConditionTrue,
+		Reason:             "AdmittedByWebhook",
+		Message:            "the spec passed the validating webhook's checks",
+		ObservedGeneration: generation,
+	})
+
+	return conditions
+}
+
+// applyClusterConditions merges the Ready/RuntimeAvailable/Validated conditions for a
+// ClusterServingRuntime, which has no Deployment of its own, into the existing condition
+// list via meta.SetStatusCondition so LastTransitionTime is only bumped on an actual flip.
+func applyClusterConditions(conditions []metav1.Condition, generation int64) []metav1.Condition {
+	for _, conditionType := range []v1alpha1.ServingRuntimeConditionType{v1alpha1.RuntimeAvailable, v1alpha1.ServingRuntimeReady} {
+		meta.SetStatusCondition(&conditions, metav1.Condition{
+			Type:               string(conditionType),
+			Status:             metav1.ConditionTrue,
+			Reason:             "ClusterScoped",
+			Message:            "ClusterServingRuntime has no Deployment of its own",
+			ObservedGeneration: generation,
+		})
+	}
+
+	meta.SetStatusCondition(&conditions, metav1.Condition{
+		Type:               string(v1alpha1.Validated),
+		Status:             metav1.ConditionTrue,
+		Reason:             "AdmittedByWebhook",
+		Message:            "the spec passed the validating webhook's checks",
+		ObservedGeneration: generation,
+	})
+
+	return conditions
+}
+
+// replicaCounts returns the Deployment's observed replica counts, or zero if it has not
+// been created yet.
+func replicaCounts(deployment *appsv1.Deployment) (replicas, readyReplicas int32) {
+	if deployment == nil {
+		return 0, 0
+	}
+	return deployment.Status.Replicas, deployment.Status.ReadyReplicas
+}
+
+// modelsStatus reports, for each distinct (name, version) this spec is eligible for
+// automatic selection of, whether it is currently the winning candidate among the given
+// siblings for at least one of its protocol versions. Each (name, version) is reported at
+// most once, regardless of how many protocol versions it wins for.
+func modelsStatus(name string, clusterScoped bool, spec v1alpha1.ServingRuntimeSpec, siblings []v1alpha1.ServingRuntimeCandidate) []v1alpha1.ServingRuntimeModelStatus {
+	self := v1alpha1.ServingRuntimeCandidate{Name: name, ClusterScoped: clusterScoped, Spec: spec}
+	candidates := append([]v1alpha1.ServingRuntimeCandidate{self}, siblings...)
+
+	var models []v1alpha1.ServingRuntimeModelStatus
+	seen := map[string]bool{}
+	for _, supportedType := range spec.SupportedModelTypes {
+		if supportedType.AutoSelect == nil || !*supportedType.AutoSelect {
+			continue
+		}
+		version := ""
+		if supportedType.Version != nil {
+			version = *supportedType.Version
+		}
+		key := supportedType.Name + "/" + version
+		if seen[key] {
+			continue
+		}
+
+		wins := false
+		for _, protocolVersion := range spec.GetProtocolVersions() {
+			sorted, err := v1alpha1.SortServingRuntimeCandidates(candidates, supportedType.Name, version, protocolVersion)
+			if err == nil && len(sorted) > 0 && sorted[0].Name == name && sorted[0].ClusterScoped == clusterScoped {
+				wins = true
+				break
+			}
+		}
+		if !wins {
+			continue
+		}
+
+		seen[key] = true
+		models = append(models, v1alpha1.ServingRuntimeModelStatus{
+			Name:     supportedType.Name,
+			Version:  supportedType.Version,
+			Priority: spec.GetPriority(supportedType.Name, version),
+		})
+	}
+	return models
+}
+
+// listNamespaceSiblingCandidates lists every other ServingRuntime in namespace and every
+// ClusterServingRuntime, disabled ones included, for use as the comparison set for a
+// namespace-scoped ServingRuntime: both kinds are visible to, and compete in, that
+// namespace. SortServingRuntimeCandidates drops the disabled ones.
+func listNamespaceSiblingCandidates(ctx context.Context, c client.Client, namespace string, selfName string) ([]v1alpha1.ServingRuntimeCandidate, error) {
+	var siblings []v1alpha1.ServingRuntimeCandidate
+
+	runtimeList := &v1alpha1.ServingRuntimeList{}
+	if err := c.List(ctx, runtimeList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for _, runtime := range runtimeList.Items {
+		if runtime.Name == selfName {
+			continue
+		}
+		siblings = append(siblings, v1alpha1.ServingRuntimeCandidate{Name: runtime.Name, Spec: runtime.Spec})
+	}
+
+	clusterRuntimeList := &v1alpha1.ClusterServingRuntimeList{}
+	if err := c.List(ctx, clusterRuntimeList); err != nil {
+		return nil, err
+	}
+	for _, runtime := range clusterRuntimeList.Items {
+		siblings = append(siblings, v1alpha1.ServingRuntimeCandidate{Name: runtime.Name, ClusterScoped: true, Spec: runtime.Spec})
+	}
+
+	return siblings, nil
+}
+
+// listClusterSiblingCandidates lists every other ClusterServingRuntime in the cluster, for
+// use as the comparison set for a ClusterServingRuntime. Namespace-scoped ServingRuntimes
+// are deliberately excluded: a ClusterServingRuntime's status has no per-namespace field,
+// so it can only meaningfully report whether it beats other cluster-scoped runtimes, not
+// whether an unrelated namespace happens to have its own higher-priority runtime.
+func listClusterSiblingCandidates(ctx context.Context, c client.Client, selfName string) ([]v1alpha1.ServingRuntimeCandidate, error) {
+	var siblings []v1alpha1.ServingRuntimeCandidate
+
+	clusterRuntimeList := &v1alpha1.ClusterServingRuntimeList{}
+	if err := c.List(ctx, clusterRuntimeList); err != nil {
+		return nil, err
+	}
+	for _, runtime := range clusterRuntimeList.Items {
+		if runtime.Name == selfName {
+			continue
+		}
+		siblings = append(siblings, v1alpha1.ServingRuntimeCandidate{Name: runtime.Name, ClusterScoped: true, Spec: runtime.Spec})
+	}
+
+	return siblings, nil
+}
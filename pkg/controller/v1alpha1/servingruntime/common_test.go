@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servingruntime
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+func boolPtr(b bool) *bool    { return &b }
+func int32Ptr(i int32) *int32 { return &i }
+
+func TestModelsStatusDedupesAcrossProtocolVersions(t *testing.T) {
+	spec := v1alpha1.ServingRuntimeSpec{
+		ProtocolVersions: []constants.InferenceServiceProtocol{constants.ProtocolV1, constants.ProtocolV2},
+		SupportedModelTypes: []v1alpha1.Framework{
+			{Name: "sklearn", AutoSelect: boolPtr(true), Priority: int32Ptr(10)},
+		},
+	}
+
+	models := modelsStatus("winner", false, spec, nil)
+
+	if len(models) != 1 {
+		t.Fatalf("got %d model status entries, want 1 (deduped across protocol versions): %+v", len(models), models)
+	}
+	if models[0].Name != "sklearn" {
+		t.Errorf("got model name %q, want sklearn", models[0].Name)
+	}
+}
+
+func TestApplyConditionsPreservesLastTransitionTimeWhenStatusUnchanged(t *testing.T) {
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+	existing := []metav1.Condition{
+		{
+			Type:               string(v1alpha1.RuntimeAvailable),
+			Status:             metav1.ConditionFalse,
+			Reason:             "DeploymentNotFound",
+			LastTransitionTime: past,
+			ObservedGeneration: 1,
+		},
+	}
+
+	updated := applyConditions(existing, nil, 2)
+
+	for _, c := range updated {
+		if c.Type == string(v1alpha1.RuntimeAvailable) {
+			if !c.LastTransitionTime.Equal(&past) {
+				t.Errorf("LastTransitionTime changed even though Status didn't flip: got %v, want %v", c.LastTransitionTime, past)
+			}
+			if c.ObservedGeneration != 2 {
+				t.Errorf("got ObservedGeneration %d, want 2", c.ObservedGeneration)
+			}
+		}
+	}
+
+	foundValidated := false
+	for _, c := range updated {
+		if c.Type == string(v1alpha1.Validated) {
+			foundValidated = true
+			if c.Status != metav1.ConditionTrue {
+				t.Errorf("got Validated status %v, want True", c.Status)
+			}
+		}
+	}
+	if !foundValidated {
+		t.Error("expected a Validated condition to be set")
+	}
+}
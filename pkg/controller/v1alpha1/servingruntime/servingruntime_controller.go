@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servingruntime
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+)
+
+// ServingRuntimeReconciler reconciles the status of a namespace-scoped ServingRuntime.
+type ServingRuntimeReconciler struct {
+	client.Client
+}
+
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=servingruntimes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=servingruntimes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch
+
+func (r *ServingRuntimeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	runtime := &v1alpha1.ServingRuntime{}
+	if err := r.Get(ctx, req.NamespacedName, runtime); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := r.Get(ctx, req.NamespacedName, deployment); err != nil {
+		if !apierr.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		deployment = nil
+	}
+
+	siblings, err := listNamespaceSiblingCandidates(ctx, r.Client, runtime.Namespace, runtime.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	runtime.Status.Conditions = applyConditions(runtime.Status.Conditions, deployment, runtime.Generation)
+	runtime.Status.Replicas, runtime.Status.ReadyReplicas = replicaCounts(deployment)
+	runtime.Status.Models = modelsStatus(runtime.Name, false, runtime.Spec, siblings)
+	runtime.Status.ObservedGeneration = runtime.Generation
+
+	return ctrl.Result{}, r.Status().Update(ctx, runtime)
+}
+
+// SetupWithManager wires up watches on ServingRuntime, its generated Deployment,
+// ClusterServingRuntime, and ServingRuntime itself -- a priority change on any of them can
+// flip which runtime wins automatic selection for a model format, so every ServingRuntime
+// needs to be re-evaluated.
+func (r *ServingRuntimeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ServingRuntime{}).
+		Owns(&appsv1.Deployment{}).
+		Watches(&v1alpha1.ClusterServingRuntime{}, handler.EnqueueRequestsFromMapFunc(r.requestsForAllServingRuntimes)).
+		Watches(&v1alpha1.ServingRuntime{}, handler.EnqueueRequestsFromMapFunc(r.requestsForNamespaceSiblings)).
+		Complete(r)
+}
+
+func (r *ServingRuntimeReconciler) requestsForAllServingRuntimes(ctx context.Context, _ client.Object) []reconcile.Request {
+	runtimeList := &v1alpha1.ServingRuntimeList{}
+	if err := r.List(ctx, runtimeList); err != nil {
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(runtimeList.Items))
+	for _, runtime := range runtimeList.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: runtime.Name, Namespace: runtime.Namespace},
+		})
+	}
+	return requests
+}
+
+// requestsForNamespaceSiblings re-enqueues every other ServingRuntime in the same namespace
+// as the changed one, excluding itself: a priority change on one can flip which of its
+// same-namespace siblings wins automatic selection for a model format.
+func (r *ServingRuntimeReconciler) requestsForNamespaceSiblings(ctx context.Context, obj client.Object) []reconcile.Request {
+	runtimeList := &v1alpha1.ServingRuntimeList{}
+	if err := r.List(ctx, runtimeList, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(runtimeList.Items))
+	for _, runtime := range runtimeList.Items {
+		if runtime.Name == obj.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: runtime.Name, Namespace: runtime.Namespace},
+		})
+	}
+	return requests
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servingruntime
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+)
+
+// endpointPattern matches the "port:<n>" or "unix:<path>" grammar documented on
+// ServingRuntimeSpec's GrpcDataEndpoint, HTTPDataEndpoint and
+// GrpcMultiModelManagementEndpoint fields.
+var endpointPattern = regexp.MustCompile(`^(port:[0-9]+|unix:/.+)$`)
+
+// validateSupportedModelTypes rejects duplicate (name, version) entries, since such
+// duplicates make automatic runtime selection ambiguous. The name is folded to lower case
+// before comparison, since selection itself matches names case-insensitively
+// (strings.EqualFold in matchingSupportedType) -- "sklearn" and "Sklearn" are the same
+// entry as far as selection is concerned.
+func validateSupportedModelTypes(supportedModelTypes []v1alpha1.Framework) error {
+	seen := map[string]bool{}
+	for _, supportedType := range supportedModelTypes {
+		version := ""
+		if supportedType.Version != nil {
+			version = *supportedType.Version
+		}
+		key := strings.ToLower(supportedType.Name) + "/" + version
+		if seen[key] {
+			return fmt.Errorf("duplicate supportedModelTypes entry for name %q version %q", supportedType.Name, version)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// validateContainerNames rejects duplicate container names in the pod spec.
+func validateContainerNames(containers []v1alpha1.Container) error {
+	seen := map[string]bool{}
+	for _, container := range containers {
+		if seen[container.Name] {
+			return fmt.Errorf("duplicate container name %q", container.Name)
+		}
+		seen[container.Name] = true
+	}
+	return nil
+}
+
+// validateBuiltInAdapterServerType rejects a BuiltInAdapter.ServerType that doesn't match
+// the name of any container in the pod spec, since the adapter is injected into that
+// container.
+func validateBuiltInAdapterServerType(spec v1alpha1.ServingRuntimeSpec) error {
+	if spec.BuiltInAdapter == nil {
+		return nil
+	}
+	serverType := string(spec.BuiltInAdapter.ServerType)
+	for _, container := range spec.Containers {
+		if container.Name == serverType {
+			return nil
+		}
+	}
+	return fmt.Errorf("builtInAdapter.serverType %q does not match any container name", serverType)
+}
+
+// validateEndpoints rejects GrpcDataEndpoint, HTTPDataEndpoint and
+// GrpcMultiModelManagementEndpoint values that don't match the "port:<n>" or
+// "unix:<path>" grammar.
+func validateEndpoints(spec v1alpha1.ServingRuntimeSpec) error {
+	endpoints := map[string]*string{
+		"grpcDataEndpoint":                 spec.GrpcDataEndpoint,
+		"httpDataEndpoint":                 spec.HTTPDataEndpoint,
+		"grpcMultiModelManagementEndpoint": spec.GrpcMultiModelManagementEndpoint,
+	}
+	for field, endpoint := range endpoints {
+		if endpoint == nil {
+			continue
+		}
+		if !endpointPattern.MatchString(*endpoint) {
+			return fmt.Errorf("%s %q must match \"port:<n>\" or \"unix:<path>\"", field, *endpoint)
+		}
+	}
+	return nil
+}
+
+// ValidateServingRuntimeSpec runs all the checks that don't require looking at other
+// objects in the cluster. Cross-runtime checks, such as priority collisions, are run
+// separately by the webhook handler since they need a client to list sibling runtimes.
+func ValidateServingRuntimeSpec(spec v1alpha1.ServingRuntimeSpec) error {
+	if err := validateSupportedModelTypes(spec.SupportedModelTypes); err != nil {
+		return err
+	}
+	if err := validateContainerNames(spec.Containers); err != nil {
+		return err
+	}
+	if err := validateBuiltInAdapterServerType(spec); err != nil {
+		return err
+	}
+	if err := validateEndpoints(spec); err != nil {
+		return err
+	}
+	return spec.ValidateProtocolVersions()
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package servingruntime implements a validating admission webhook for ServingRuntime and
+// ClusterServingRuntime.
+package servingruntime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-serving-kserve-io-v1alpha1-servingruntime,mutating=false,failurePolicy=fail,groups=serving.kserve.io,resources=servingruntimes;clusterservingruntimes,versions=v1alpha1,name=servingruntime.kserve-webhook-server.validator,sideEffects=None,admissionReviewVersions=v1
+
+// Validator validates ServingRuntime and ClusterServingRuntime resources on create/update.
+type Validator struct {
+	Client  client.Client
+	Decoder admission.Decoder
+}
+
+var _ admission.Handler = &Validator{}
+
+// Handle decodes the incoming ServingRuntime or ClusterServingRuntime, runs the
+// self-contained spec checks, and then checks for priority collisions against the
+// runtimes already present in the cluster.
+func (v *Validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	spec, name, namespace, err := v.decodeSpec(req)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if err := ValidateServingRuntimeSpec(*spec); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	if err := v.validatePriorityCollisions(ctx, name, namespace, *spec); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	return admission.Allowed("")
+}
+
+func (v *Validator) decodeSpec(req admission.Request) (*v1alpha1.ServingRuntimeSpec, string, string, error) {
+	switch req.Kind.Kind {
+	case "ServingRuntime":
+		sr := &v1alpha1.ServingRuntime{}
+		if err := v.Decoder.Decode(req, sr); err != nil {
+			return nil, "", "", err
+		}
+		return &sr.Spec, sr.Name, sr.Namespace, nil
+	case "ClusterServingRuntime":
+		csr := &v1alpha1.ClusterServingRuntime{}
+		if err := v.Decoder.Decode(req, csr); err != nil {
+			return nil, "", "", err
+		}
+		return &csr.Spec, csr.Name, "", nil
+	default:
+		return nil, "", "", fmt.Errorf("unexpected admission request kind %q", req.Kind.Kind)
+	}
+}
+
+// validatePriorityCollisions lists the sibling runtimes (the ServingRuntimes in the same
+// namespace, for a namespace-scoped runtime, and every ClusterServingRuntime), substitutes
+// in the runtime under review, and asks the runtime-selection
+// logic to sort candidates for every (modelFormat, version, protocolVersion) combination
+// this runtime supports -- which fails if it collides on priority with another runtime.
+func (v *Validator) validatePriorityCollisions(ctx context.Context, name string, namespace string, spec v1alpha1.ServingRuntimeSpec) error {
+	candidates, err := v.listCandidates(ctx, namespace, name, spec)
+	if err != nil {
+		return err
+	}
+
+	for _, supportedType := range spec.SupportedModelTypes {
+		if supportedType.AutoSelect == nil || !*supportedType.AutoSelect {
+			continue
+		}
+		version := ""
+		if supportedType.Version != nil {
+			version = *supportedType.Version
+		}
+		for _, protocolVersion := range spec.GetProtocolVersions() {
+			if _, err := v1alpha1.SortServingRuntimeCandidates(candidates, supportedType.Name, version, protocolVersion); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// listCandidates builds the full candidate list for priority-collision checking: every
+// other ServingRuntime in namespace and ClusterServingRuntime in the cluster, disabled ones
+// included (SortServingRuntimeCandidates drops them), plus
+// the runtime under review (replacing its prior version, if any, so an update is compared
+// against its own new spec rather than its own old one).
+func (v *Validator) listCandidates(ctx context.Context, namespace string, name string, spec v1alpha1.ServingRuntimeSpec) ([]v1alpha1.ServingRuntimeCandidate, error) {
+	candidates := []v1alpha1.ServingRuntimeCandidate{{Name: name, ClusterScoped: namespace == "", Spec: spec}}
+
+	if namespace != "" {
+		runtimeList := &v1alpha1.ServingRuntimeList{}
+		if err := v.Client.List(ctx, runtimeList, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+		for _, runtime := range runtimeList.Items {
+			if runtime.Name == name {
+				continue
+			}
+			candidates = append(candidates, v1alpha1.ServingRuntimeCandidate{Name: runtime.Name, Spec: runtime.Spec})
+		}
+	}
+
+	clusterRuntimeList := &v1alpha1.ClusterServingRuntimeList{}
+	if err := v.Client.List(ctx, clusterRuntimeList); err != nil {
+		return nil, err
+	}
+	for _, runtime := range clusterRuntimeList.Items {
+		if namespace == "" && runtime.Name == name {
+			continue
+		}
+		candidates = append(candidates, v1alpha1.ServingRuntimeCandidate{Name: runtime.Name, ClusterScoped: true, Spec: runtime.Spec})
+	}
+
+	return candidates, nil
+}
+
+// NewValidator builds a Validator with its decoder constructed from the manager's scheme,
+// ready to be registered on the manager's webhook server.
+func NewValidator(c client.Client, scheme *runtime.Scheme) *Validator {
+	return &Validator{Client: c, Decoder: admission.NewDecoder(scheme)}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servingruntime
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/constants"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to add v1alpha1 to scheme: %v", err)
+	}
+	return scheme
+}
+
+func newCreateRequest(t *testing.T, sr *v1alpha1.ServingRuntime) admission.Request {
+	t.Helper()
+	raw, err := json.Marshal(sr)
+	if err != nil {
+		t.Fatalf("unable to marshal ServingRuntime: %v", err)
+	}
+	return admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Group: "serving.kserve.io", Version: "v1alpha1", Kind: "ServingRuntime"},
+			Operation: admissionv1.Create,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func TestHandleAllowsValidServingRuntime(t *testing.T) {
+	scheme := newScheme(t)
+	validator := NewValidator(fake.NewClientBuilder().WithScheme(scheme).Build(), scheme)
+
+	sr := &v1alpha1.ServingRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "sklearn-runtime", Namespace: "default"},
+		Spec: v1alpha1.ServingRuntimeSpec{
+			ServingRuntimePodSpec: v1alpha1.ServingRuntimePodSpec{
+				Containers: []v1alpha1.Container{{Name: "sklearn", Image: "sklearnserver:latest"}},
+			},
+		},
+	}
+
+	resp := validator.Handle(context.Background(), newCreateRequest(t, sr))
+	if !resp.Allowed {
+		t.Fatalf("expected request to be allowed, got denied: %s", resp.Result.Message)
+	}
+}
+
+func TestHandleDeniesDuplicateContainerNames(t *testing.T) {
+	scheme := newScheme(t)
+	validator := NewValidator(fake.NewClientBuilder().WithScheme(scheme).Build(), scheme)
+
+	sr := &v1alpha1.ServingRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "sklearn-runtime", Namespace: "default"},
+		Spec: v1alpha1.ServingRuntimeSpec{
+			ServingRuntimePodSpec: v1alpha1.ServingRuntimePodSpec{
+				Containers: []v1alpha1.Container{
+					{Name: "sklearn", Image: "sklearnserver:latest"},
+					{Name: "sklearn", Image: "sklearnserver:other"},
+				},
+			},
+		},
+	}
+
+	resp := validator.Handle(context.Background(), newCreateRequest(t, sr))
+	if resp.Allowed {
+		t.Fatal("expected request with duplicate container names to be denied")
+	}
+}
+
+func TestHandleDeniesDuplicateModelTypesCaseInsensitively(t *testing.T) {
+	scheme := newScheme(t)
+	validator := NewValidator(fake.NewClientBuilder().WithScheme(scheme).Build(), scheme)
+
+	sr := &v1alpha1.ServingRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "sklearn-runtime", Namespace: "default"},
+		Spec: v1alpha1.ServingRuntimeSpec{
+			SupportedModelTypes: []v1alpha1.Framework{
+				{Name: "sklearn"},
+				{Name: "Sklearn"},
+			},
+			ServingRuntimePodSpec: v1alpha1.ServingRuntimePodSpec{
+				Containers: []v1alpha1.Container{{Name: "sklearn", Image: "sklearnserver:latest"}},
+			},
+		},
+	}
+
+	resp := validator.Handle(context.Background(), newCreateRequest(t, sr))
+	if resp.Allowed {
+		t.Fatal("expected request with case-insensitively duplicate model types to be denied")
+	}
+}
+
+func TestHandleDeniesBuiltInAdapterServerTypeNotMatchingAnyContainer(t *testing.T) {
+	scheme := newScheme(t)
+	validator := NewValidator(fake.NewClientBuilder().WithScheme(scheme).Build(), scheme)
+
+	sr := &v1alpha1.ServingRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "sklearn-runtime", Namespace: "default"},
+		Spec: v1alpha1.ServingRuntimeSpec{
+			BuiltInAdapter: &v1alpha1.BuiltInAdapter{ServerType: v1alpha1.Triton},
+			ServingRuntimePodSpec: v1alpha1.ServingRuntimePodSpec{
+				Containers: []v1alpha1.Container{{Name: "sklearn", Image: "sklearnserver:latest"}},
+			},
+		},
+	}
+
+	resp := validator.Handle(context.Background(), newCreateRequest(t, sr))
+	if resp.Allowed {
+		t.Fatal("expected request with a builtInAdapter.serverType matching no container to be denied")
+	}
+}
+
+func TestHandleDeniesMalformedEndpoint(t *testing.T) {
+	scheme := newScheme(t)
+	validator := NewValidator(fake.NewClientBuilder().WithScheme(scheme).Build(), scheme)
+
+	badEndpoint := "localhost:1234"
+	sr := &v1alpha1.ServingRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "sklearn-runtime", Namespace: "default"},
+		Spec: v1alpha1.ServingRuntimeSpec{
+			GrpcDataEndpoint: &badEndpoint,
+			ServingRuntimePodSpec: v1alpha1.ServingRuntimePodSpec{
+				Containers: []v1alpha1.Container{{Name: "sklearn", Image: "sklearnserver:latest"}},
+			},
+		},
+	}
+
+	resp := validator.Handle(context.Background(), newCreateRequest(t, sr))
+	if resp.Allowed {
+		t.Fatal("expected request with a malformed endpoint to be denied")
+	}
+}
+
+func TestHandleDeniesProtocolVersionMissingDataEndpoint(t *testing.T) {
+	scheme := newScheme(t)
+	validator := NewValidator(fake.NewClientBuilder().WithScheme(scheme).Build(), scheme)
+
+	mmEndpoint := "port:8001"
+	sr := &v1alpha1.ServingRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "sklearn-runtime", Namespace: "default"},
+		Spec: v1alpha1.ServingRuntimeSpec{
+			GrpcMultiModelManagementEndpoint: &mmEndpoint,
+			ProtocolVersions:                 []constants.InferenceServiceProtocol{constants.ProtocolV1},
+			ServingRuntimePodSpec: v1alpha1.ServingRuntimePodSpec{
+				Containers: []v1alpha1.Container{{Name: "sklearn", Image: "sklearnserver:latest"}},
+			},
+		},
+	}
+
+	resp := validator.Handle(context.Background(), newCreateRequest(t, sr))
+	if resp.Allowed {
+		t.Fatal("expected request advertising protocol v1 without httpDataEndpoint to be denied")
+	}
+}